@@ -0,0 +1,48 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package v1alpha1
+
+// Destination provides the configuration that will be applied to the
+// destination Kubernetes Secret during a sync.
+type Destination struct {
+	// Name of the Secret
+	Name string `json:"name"`
+	// Create the destination Secret. If the Secret already exists this should be set to false.
+	// +optional
+	Create bool `json:"create,omitempty"`
+}
+
+// CleanupPolicy controls what, if anything, a controller does in Vault when its
+// CustomResource is deleted. It is shared across resource types (VaultPKISecret,
+// and the KV secrets engine equivalent) that manage revocable Vault material, so
+// that all of them expose the same Retain/Revoke/RevokeAndTidy vocabulary.
+// +kubebuilder:validation:Enum=Retain;Revoke;RevokeAndTidy
+type CleanupPolicy string
+
+const (
+	// CleanupPolicyRetain leaves the Vault-side material untouched on delete.
+	CleanupPolicyRetain CleanupPolicy = "Retain"
+	// CleanupPolicyRevoke revokes the Vault-side material on delete, analogous
+	// to a kv-v2 soft delete: the metadata is still recoverable in Vault.
+	CleanupPolicyRevoke CleanupPolicy = "Revoke"
+	// CleanupPolicyRevokeAndTidy revokes the Vault-side material and then
+	// triggers a tidy operation to purge its metadata from Vault storage,
+	// analogous to a kv-v2 destroy.
+	CleanupPolicyRevokeAndTidy CleanupPolicy = "RevokeAndTidy"
+)
+
+// TidyConfig controls the pki/tidy parameters used by CleanupPolicyRevokeAndTidy.
+type TidyConfig struct {
+	// TidyCertStore requests that Vault remove the stored certificate for the
+	// revoked serial, corresponding to pki/tidy's tidy_cert_store parameter.
+	// +optional
+	// +kubebuilder:default=true
+	TidyCertStore bool `json:"tidyCertStore,omitempty"`
+	// SafetyBuffer is the minimum age a revoked certificate must have before
+	// tidy will remove it, corresponding to pki/tidy's safety_buffer parameter,
+	// e.g. `72h`.
+	// +optional
+	// +kubebuilder:default="72h"
+	SafetyBuffer string `json:"safetyBuffer,omitempty"`
+}
@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// VaultAuthConfigKubernetes provides the configuration for the Kubernetes Auth Method.
+type VaultAuthConfigKubernetes struct {
+	// Role to use for authenticating to Vault.
+	Role string `json:"role"`
+	// ServiceAccount to use when authenticating to Vault's
+	// authentication backend. This must reside in the consuming secret's (VDS/VSS/PKI) namespace.
+	ServiceAccount string `json:"serviceAccount"`
+	// TokenAudiences to include in the ServiceAccount token.
+	// +optional
+	TokenAudiences []string `json:"audiences,omitempty"`
+}
+
+// VaultAuthSpec defines the desired state of VaultAuth
+type VaultAuthSpec struct {
+	// VaultConnectionRef of the corresponding VaultConnection CustomResource.
+	// If no value is specified the Operator will default to the VaultConnection named
+	// `default` in this VaultAuth's namespace; the operator's own namespace is not searched.
+	// +optional
+	VaultConnectionRef string `json:"vaultConnectionRef,omitempty"`
+	// Namespace to use for the Vault operations performed with the token obtained from this
+	// VaultAuth, e.g. the PKI or KV secrets engine referenced by the consuming VaultPKISecret/
+	// VaultStaticSecret.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// AuthNamespace is the Vault namespace the login/auth method is configured in. In Vault
+	// Enterprise a shared auth mount in a parent namespace can issue tokens usable in tenant
+	// child namespaces, so this may differ from Namespace. Defaults to Namespace when unset.
+	// +optional
+	AuthNamespace string `json:"authNamespace,omitempty"`
+	// Method to use when authenticating to Vault.
+	Method string `json:"method,omitempty"`
+	// Mount to use when authenticating to auth method.
+	Mount string `json:"mount,omitempty"`
+	// Kubernetes specific auth configuration, requires that the Method be set to `kubernetes`.
+	// +optional
+	Kubernetes *VaultAuthConfigKubernetes `json:"kubernetes,omitempty"`
+}
+
+// GetAuthNamespace returns the Vault namespace that the login/auth method
+// should be performed against, defaulting to Namespace when AuthNamespace is
+// unset.
+func (s *VaultAuthSpec) GetAuthNamespace() string {
+	if s.AuthNamespace != "" {
+		return s.AuthNamespace
+	}
+	return s.Namespace
+}
+
+// VaultAuthStatus defines the observed state of VaultAuth
+type VaultAuthStatus struct {
+	// Valid auth mechanism.
+	Valid bool `json:"valid"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// VaultAuth is the Schema for the vaultauths API
+type VaultAuth struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VaultAuthSpec   `json:"spec,omitempty"`
+	Status VaultAuthStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VaultAuthList contains a list of VaultAuth
+type VaultAuthList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VaultAuth `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&VaultAuth{}, &VaultAuthList{})
+}
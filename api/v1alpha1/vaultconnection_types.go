@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// VaultConnectionSpec defines the desired state of VaultConnection
+type VaultConnectionSpec struct {
+	// Address of the Vault server
+	Address string `json:"address"`
+	// Headers to be included in all Vault requests.
+	// +optional
+	Headers map[string]string `json:"headers,omitempty"`
+	// TLSServerName to use as the SNI host for TLS connections.
+	// +optional
+	TLSServerName string `json:"tlsServerName,omitempty"`
+	// CACertSecretRef is the name of a Kubernetes secret, in the same namespace as this
+	// VaultConnection, that holds a PEM encoded CA certificate bundle (key `ca.crt`) that
+	// will be used to verify the Vault server's TLS certificate.
+	// +optional
+	CACertSecretRef string `json:"caCertSecretRef,omitempty"`
+	// SkipTLSVerify disables verification of the Vault server's TLS certificate chain.
+	// This should only be used for testing/development purposes.
+	// +optional
+	SkipTLSVerify bool `json:"skipTLSVerify,omitempty"`
+	// ClientCertSecretRef is the name of a Kubernetes secret, in the same namespace as this
+	// VaultConnection, that holds a client certificate (key `tls.crt`) for mutual TLS
+	// authentication to Vault. The private key is read from the same Secret, under ClientKeyRef.
+	// +optional
+	ClientCertSecretRef string `json:"clientCertSecretRef,omitempty"`
+	// ClientKeyRef is the key within the Secret referenced by ClientCertSecretRef that holds
+	// the PEM encoded private key corresponding to the client certificate. Defaults to
+	// `tls.key` when unset.
+	// +optional
+	ClientKeyRef string `json:"clientKeyRef,omitempty"`
+}
+
+// VaultConnectionStatus defines the observed state of VaultConnection
+type VaultConnectionStatus struct {
+	// Valid connection configuration.
+	Valid bool `json:"valid"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// VaultConnection is the Schema for the vaultconnections API
+type VaultConnection struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VaultConnectionSpec   `json:"spec,omitempty"`
+	Status VaultConnectionStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VaultConnectionList contains a list of VaultConnection
+type VaultConnectionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VaultConnection `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&VaultConnection{}, &VaultConnectionList{})
+}
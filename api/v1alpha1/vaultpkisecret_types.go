@@ -0,0 +1,152 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// VaultPKISecretSpec defines the desired state of VaultPKISecret
+type VaultPKISecretSpec struct {
+	// VaultAuthRef to the VaultAuth resource, can be prefixed with a namespace, eg:
+	// `namespaceA/vaultAuthRefB`. If no namespace prefix is provided it will default to
+	// the namespace of this VaultPKISecret.
+	// +optional
+	VaultAuthRef string `json:"vaultAuthRef,omitempty"`
+	// Namespace where the secrets engine is mounted in Vault. The controller does not read
+	// this field: PKI requests are sent to the Namespace of the referenced VaultAuth.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// Mount for the secret in Vault
+	Mount string `json:"mount"`
+	// Name of the PKI role to issue/sign the certificate against.
+	Name string `json:"name"`
+	// CommonName to include in the certificate request. Must be empty, or must match the
+	// CSR's subject CommonName, when CSR is set.
+	// +optional
+	CommonName string `json:"commonName,omitempty"`
+	// AltNames to include in the certificate request. Must be empty, or must match the CSR's
+	// subject alt names, when CSR is set.
+	// +optional
+	AltNames []string `json:"altNames,omitempty"`
+	// CSR is a PEM encoded certificate signing request. When set, the controller calls Vault's
+	// pki/sign/:role endpoint instead of pki/issue/:role, letting the caller retain control of
+	// the private key instead of having Vault generate one. Mutually exclusive with
+	// PrivateKeySecretRef.
+	// +optional
+	CSR string `json:"csr,omitempty"`
+	// PrivateKeySecretRef names a Secret, in this resource's namespace, holding a PEM encoded
+	// EC private key in SEC1 form (`EC PRIVATE KEY`, key `tls.key`); other key types are
+	// rejected. If the Secret does not exist, the controller generates an ECDSA P-256 key and
+	// creates it. When set and CSR is unset, the controller builds a CSR from this key on each
+	// issuance and calls pki/sign/:role, reusing the same key across rotations, so that
+	// workloads pinning to this key (e.g. HPKP-style pinning, or apps that load the key once
+	// at startup) are not broken by certificate rotation. Mutually exclusive with CSR.
+	// +optional
+	PrivateKeySecretRef string `json:"privateKeySecretRef,omitempty"`
+	// IPSANs to include in the certificate request.
+	// +optional
+	IPSANs []string `json:"ipSans,omitempty"`
+	// TTL for the certificate
+	// +optional
+	TTL string `json:"ttl,omitempty"`
+	// Format of the returned certificate, can be `pem`, `der`, or `pem_bundle`.
+	// +optional
+	// +kubebuilder:default=pem
+	Format string `json:"format,omitempty"`
+	// ExpiryOffset is the time before the certificate's expiration to trigger a rotation.
+	// Deprecated: use RenewBefore instead. Used as the fallback for RenewBefore when
+	// RenewBefore is unset, so existing users relying on ExpiryOffset keep their
+	// rotation cadence.
+	// +optional
+	ExpiryOffset string `json:"expiryOffset,omitempty"`
+	// RenewBefore is the duration prior to the issued certificate's NotAfter at which the
+	// controller will proactively request a new certificate, e.g. `1h`, `720h`. Falls back to
+	// ExpiryOffset when unset, and to 1/3 of the certificate's actual lifetime
+	// (NotAfter - NotBefore) when neither is set.
+	// +optional
+	RenewBefore string `json:"renewBefore,omitempty"`
+	// Revoke the certificate when the resource is deleted.
+	// Deprecated: use CleanupPolicy instead. Revoke: true is equivalent to CleanupPolicy: Revoke.
+	// +optional
+	Revoke bool `json:"revoke,omitempty"`
+	// CleanupPolicy controls what the controller does in Vault when this resource is deleted:
+	// Retain leaves the certificate alone, Revoke revokes it, and RevokeAndTidy revokes it and
+	// additionally runs pki/tidy to purge its serial metadata from Vault storage. Defaults to
+	// Revoke when unset and Revoke is true, otherwise defaults to Retain.
+	// +optional
+	CleanupPolicy CleanupPolicy `json:"cleanupPolicy,omitempty"`
+	// Tidy configures the pki/tidy call made when CleanupPolicy is RevokeAndTidy. Ignored
+	// otherwise.
+	// +optional
+	Tidy TidyConfig `json:"tidy,omitempty"`
+	// Clear the Kubernetes secret when the resource is deleted.
+	// +optional
+	Clear bool `json:"clear,omitempty"`
+	// Destination provides the configuration necessary for syncing the Vault secret to Kubernetes.
+	Destination Destination `json:"destination"`
+}
+
+// GetRenewBefore returns the effective RenewBefore, falling back to the
+// deprecated ExpiryOffset for backwards compatibility when RenewBefore is
+// unset, so existing users who only set ExpiryOffset keep their rotation
+// cadence across the upgrade to NotAfter-driven rotation.
+func (s *VaultPKISecretSpec) GetRenewBefore() string {
+	if s.RenewBefore != "" {
+		return s.RenewBefore
+	}
+	return s.ExpiryOffset
+}
+
+// GetCleanupPolicy returns the effective CleanupPolicy, falling back to the
+// deprecated Revoke bool for backwards compatibility when CleanupPolicy is unset.
+func (s *VaultPKISecretSpec) GetCleanupPolicy() CleanupPolicy {
+	if s.CleanupPolicy != "" {
+		return s.CleanupPolicy
+	}
+	if s.Revoke {
+		return CleanupPolicyRevoke
+	}
+	return CleanupPolicyRetain
+}
+
+// VaultPKISecretStatus defines the observed state of VaultPKISecret
+type VaultPKISecretStatus struct {
+	// SerialNumber of the most recently issued certificate.
+	SerialNumber string `json:"serialNumber,omitempty"`
+	// Expiration is the Unix time representing the certificate's expiration.
+	Expiration int64 `json:"expiration,omitempty"`
+	// LastRenewalTime of the last certificate issuance/rotation.
+	LastRenewalTime int64 `json:"lastRenewalTime,omitempty"`
+	// Conditions represent the latest available observations of this resource's state,
+	// including a Tidy condition with reason Pending recorded when a RevokeAndTidy teardown
+	// starts. The outcome of the tidy call itself is not recorded here.
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// VaultPKISecret is the Schema for the vaultpkisecrets API
+type VaultPKISecret struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VaultPKISecretSpec   `json:"spec,omitempty"`
+	Status VaultPKISecretStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VaultPKISecretList contains a list of VaultPKISecret
+type VaultPKISecretList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VaultPKISecret `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&VaultPKISecret{}, &VaultPKISecretList{})
+}
@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package v1alpha1
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// +kubebuilder:webhook:path=/validate-secrets-hashicorp-com-v1alpha1-vaultpkisecret,mutating=false,failurePolicy=fail,sideEffects=None,groups=secrets.hashicorp.com,resources=vaultpkisecrets,verbs=create;update,versions=v1alpha1,name=vvaultpkisecret.kb.io,admissionReviewVersions=v1
+
+// SetupWebhookWithManager registers the validating webhook for VaultPKISecret.
+func (r *VaultPKISecret) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+var _ webhook.Validator = &VaultPKISecret{}
+
+// ValidateCreate implements webhook.Validator.
+func (r *VaultPKISecret) ValidateCreate() (admission.Warnings, error) {
+	return nil, r.Spec.validate()
+}
+
+// ValidateUpdate implements webhook.Validator.
+func (r *VaultPKISecret) ValidateUpdate(_ runtime.Object) (admission.Warnings, error) {
+	return nil, r.Spec.validate()
+}
+
+// ValidateDelete implements webhook.Validator.
+func (r *VaultPKISecret) ValidateDelete() (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validate rejects spec combinations that the controller cannot reconcile:
+// CSR-based issuance conflicts with a CommonName/AltNames requesting a
+// different subject than the CSR itself, and CSR/PrivateKeySecretRef are
+// mutually exclusive ways of keeping the private key outside of Vault.
+func (s *VaultPKISecretSpec) validate() error {
+	if s.CSR == "" {
+		return nil
+	}
+
+	if s.PrivateKeySecretRef != "" {
+		return fmt.Errorf("csr and privateKeySecretRef are mutually exclusive")
+	}
+
+	block, _ := pem.Decode([]byte(s.CSR))
+	if block == nil {
+		return fmt.Errorf("csr is not a valid PEM encoded certificate request")
+	}
+
+	csr, err := x509.ParseCertificateRequest(block.Bytes)
+	if err != nil {
+		return fmt.Errorf("failed to parse csr: %w", err)
+	}
+
+	if s.CommonName != "" && s.CommonName != csr.Subject.CommonName {
+		return fmt.Errorf("commonName %q conflicts with csr subject CommonName %q", s.CommonName, csr.Subject.CommonName)
+	}
+
+	if len(s.AltNames) > 0 && !sameStringSet(s.AltNames, csr.DNSNames) {
+		return fmt.Errorf("altNames %v conflict with csr DNS SANs %v", s.AltNames, csr.DNSNames)
+	}
+
+	return nil
+}
+
+func sameStringSet(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	seen := make(map[string]int, len(a))
+	for _, s := range a {
+		seen[s]++
+	}
+	for _, s := range b {
+		seen[s]--
+	}
+	for _, count := range seen {
+		if count != 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// VaultWebhookCertificateSpec defines the desired state of VaultWebhookCertificate.
+// It is reconciled early in operator startup so that the admission/conversion
+// webhook TLS material can be sourced from Vault PKI instead of cert-manager or
+// a static Secret.
+type VaultWebhookCertificateSpec struct {
+	// VaultAuthRef to the VaultAuth resource used to authenticate to Vault.
+	// +optional
+	VaultAuthRef string `json:"vaultAuthRef,omitempty"`
+	// Namespace where the PKI secrets engine is mounted in Vault. The controller does not
+	// read this field: PKI requests are sent to the Namespace of the referenced VaultAuth.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// Mount for the PKI secrets engine in Vault.
+	Mount string `json:"mount"`
+	// Role is the name of the PKI role to issue the certificate against. The
+	// role's allowed_domains must match ServiceName.ServiceNamespace.svc.
+	Role string `json:"role"`
+	// ServiceName of the Kubernetes Service fronting the webhook, used to
+	// compute the certificate's CommonName as `<ServiceName>.<ServiceNamespace>.svc`.
+	ServiceName string `json:"serviceName"`
+	// ServiceNamespace of the Kubernetes Service fronting the webhook.
+	ServiceNamespace string `json:"serviceNamespace"`
+	// SecretName is the name of the Secret, in ServiceNamespace, that holds the
+	// webhook server's TLS material (tls.crt/tls.key).
+	SecretName string `json:"secretName"`
+	// RenewBefore is the duration prior to the issued certificate's NotAfter at
+	// which the controller proactively rotates it. Defaults to 1/3 of the
+	// certificate's lifetime when unset.
+	// +optional
+	RenewBefore string `json:"renewBefore,omitempty"`
+}
+
+// VaultWebhookCertificateStatus defines the observed state of VaultWebhookCertificate
+type VaultWebhookCertificateStatus struct {
+	// SerialNumber of the most recently issued certificate.
+	SerialNumber string `json:"serialNumber,omitempty"`
+	// Expiration is the Unix time representing the certificate's expiration.
+	Expiration int64 `json:"expiration,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// VaultWebhookCertificate is the Schema for the vaultwebhookcertificates API
+type VaultWebhookCertificate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VaultWebhookCertificateSpec   `json:"spec,omitempty"`
+	Status VaultWebhookCertificateStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VaultWebhookCertificateList contains a list of VaultWebhookCertificate
+type VaultWebhookCertificateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VaultWebhookCertificate `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&VaultWebhookCertificate{}, &VaultWebhookCertificateList{})
+}
@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/vault/api"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	secretsv1alpha1 "github.com/hashicorp/vault-secrets-operator/api/v1alpha1"
+	"github.com/hashicorp/vault-secrets-operator/internal/vault"
+)
+
+// newAuthenticatedClient resolves vaultAuthRef (optionally prefixed with
+// `<namespace>/`, defaulting to namespace when unprefixed) to a VaultAuth,
+// resolves that VaultAuth's VaultConnectionRef to a VaultConnection, builds a
+// *api.Client from it, logs in, and leaves the client's namespace set to the
+// VaultAuth's data-path Namespace, ready for PKI/KV requests.
+func newAuthenticatedClient(ctx context.Context, c client.Client, factory *vault.ClientFactory, namespace, vaultAuthRef string) (*api.Client, error) {
+	authNamespace, authName := namespace, vaultAuthRef
+	if idx := strings.Index(vaultAuthRef, "/"); idx >= 0 {
+		authNamespace, authName = vaultAuthRef[:idx], vaultAuthRef[idx+1:]
+	}
+
+	authObj := &secretsv1alpha1.VaultAuth{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: authNamespace, Name: authName}, authObj); err != nil {
+		return nil, fmt.Errorf("failed to get VaultAuth %s/%s: %w", authNamespace, authName, err)
+	}
+
+	connection := &secretsv1alpha1.VaultConnection{}
+	connectionName := authObj.Spec.VaultConnectionRef
+	if connectionName == "" {
+		connectionName = "default"
+	}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: authObj.Namespace, Name: connectionName}, connection); err != nil {
+		return nil, fmt.Errorf("failed to get VaultConnection %s/%s: %w", authObj.Namespace, connectionName, err)
+	}
+
+	vc, err := factory.NewClient(ctx, connection)
+	if err != nil {
+		return nil, fmt.Errorf("failed to construct Vault client: %w", err)
+	}
+
+	secret, err := factory.LoginKubernetes(ctx, vc, authObj, namespace)
+	if err != nil {
+		return nil, fmt.Errorf("failed to login to Vault: %w", err)
+	}
+	if secret == nil || secret.Auth == nil {
+		return nil, fmt.Errorf("login to Vault auth method %q returned no token", authObj.Spec.Mount)
+	}
+
+	vc.SetToken(secret.Auth.ClientToken)
+	vc.SetNamespace(authObj.Spec.Namespace)
+
+	return vc, nil
+}
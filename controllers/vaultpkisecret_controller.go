@@ -0,0 +1,635 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package controllers
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	vaultapi "github.com/hashicorp/vault/api"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	secretsv1alpha1 "github.com/hashicorp/vault-secrets-operator/api/v1alpha1"
+	"github.com/hashicorp/vault-secrets-operator/internal/vault"
+)
+
+// minRequeueAfter is the floor applied to a computed RequeueAfter so that clock
+// skew, or a renewal deadline that has already passed, never results in a tight
+// reconcile loop.
+const minRequeueAfter = 5 * time.Second
+
+// vaultPKISecretFinalizer lets the controller revoke/tidy Vault-side material
+// before the VaultPKISecret, and the Secret it owns, are removed from the API.
+const vaultPKISecretFinalizer = "vaultpkisecret.secrets.hashicorp.com/finalizer"
+
+// tidyCondition is the .status.conditions type used to surface the outcome of
+// an asynchronous RevokeAndTidy teardown.
+const tidyCondition = "Tidy"
+
+// VaultPKISecretReconciler reconciles a VaultPKISecret object
+type VaultPKISecretReconciler struct {
+	client.Client
+	Scheme        *runtime.Scheme
+	ClientFactory *vault.ClientFactory
+}
+
+// +kubebuilder:rbac:groups=secrets.hashicorp.com,resources=vaultpkisecrets,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=secrets.hashicorp.com,resources=vaultpkisecrets/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;create;update;patch;delete
+
+// Reconcile issues or rotates the Vault PKI certificate backing a VaultPKISecret.
+// Rather than polling on a fixed interval, it parses the issued leaf certificate's
+// NotAfter and schedules the next reconcile to land exactly on the renewal deadline.
+func (r *VaultPKISecretReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	o := &secretsv1alpha1.VaultPKISecret{}
+	if err := r.Client.Get(ctx, req.NamespacedName, o); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	if !o.DeletionTimestamp.IsZero() {
+		return r.reconcileDelete(ctx, o)
+	}
+
+	if !controllerutil.ContainsFinalizer(o, vaultPKISecretFinalizer) {
+		controllerutil.AddFinalizer(o, vaultPKISecretFinalizer)
+		if err := r.Client.Update(ctx, o); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to add finalizer: %w", err)
+		}
+	}
+
+	destSecret, err := r.getDestinationSecret(ctx, o)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if destSecret != nil {
+		cert, err := parseLeafCertificate(destSecret.Data["tls.crt"])
+		if err != nil {
+			logger.Error(err, "failed to parse existing certificate, forcing re-issuance")
+		} else if !needsRotation(cert, o) {
+			return ctrl.Result{RequeueAfter: renewalRequeueAfter(cert, o)}, nil
+		}
+	}
+
+	previousSerial := o.Status.SerialNumber
+
+	cert, err := r.issueCertificate(ctx, o)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to issue certificate: %w", err)
+	}
+
+	if err := r.swapSecret(ctx, o, destSecret, cert); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to update destination Secret: %w", err)
+	}
+
+	o.Status.SerialNumber = cert.serialNumber
+	o.Status.Expiration = cert.leaf.NotAfter.Unix()
+	o.Status.LastRenewalTime = time.Now().Unix()
+	if err := r.Client.Status().Update(ctx, o); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to update status: %w", err)
+	}
+
+	// The superseded serial is only revoked now that the new Secret has been
+	// observed (written and recorded on status): revoking it any earlier risks
+	// leaving consumers with a Secret that still references an already-revoked
+	// certificate if a failure occurs in between. A revoke failure here is
+	// logged rather than failing the reconcile, since rotation has already
+	// succeeded.
+	if previousSerial != "" && previousSerial != cert.serialNumber {
+		if err := r.revoke(ctx, o, previousSerial); err != nil {
+			logger.Error(err, "failed to revoke superseded certificate", "serialNumber", previousSerial)
+		}
+	}
+
+	return ctrl.Result{RequeueAfter: renewalRequeueAfter(cert.leaf, o)}, nil
+}
+
+// reconcileDelete implements the CleanupPolicy on a VaultPKISecret being
+// deleted. Revoke is performed synchronously, since it's expected to be fast
+// and its result determines whether the Secret is safe to remove. Tidy, when
+// requested via RevokeAndTidy, is kicked off afterwards without blocking
+// finalizer removal: if Vault is temporarily unreachable for the tidy call,
+// the already-revoked certificate, and the deletion of this resource, should
+// not be held up waiting for it. A Tidy condition with reason Pending is
+// recorded on .status.conditions before the finalizer is removed; the outcome
+// of the tidy call itself is only logged by tidyAsync and is not written to
+// status.
+func (r *VaultPKISecretReconciler) reconcileDelete(ctx context.Context, o *secretsv1alpha1.VaultPKISecret) (ctrl.Result, error) {
+	if !controllerutil.ContainsFinalizer(o, vaultPKISecretFinalizer) {
+		return ctrl.Result{}, nil
+	}
+
+	policy := o.Spec.GetCleanupPolicy()
+	if policy == secretsv1alpha1.CleanupPolicyRevoke || policy == secretsv1alpha1.CleanupPolicyRevokeAndTidy {
+		if err := r.revoke(ctx, o, o.Status.SerialNumber); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to revoke certificate serial %q: %w", o.Status.SerialNumber, err)
+		}
+	}
+
+	if o.Spec.Clear {
+		if err := r.deleteDestinationSecret(ctx, o); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	tidyCopy := o.DeepCopy()
+	if policy == secretsv1alpha1.CleanupPolicyRevokeAndTidy {
+		setTidyCondition(&o.Status, "Pending", nil)
+		if err := r.Client.Status().Update(ctx, o); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to record pending tidy condition: %w", err)
+		}
+	}
+
+	controllerutil.RemoveFinalizer(o, vaultPKISecretFinalizer)
+	if err := r.Client.Update(ctx, o); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to remove finalizer: %w", err)
+	}
+
+	if policy == secretsv1alpha1.CleanupPolicyRevokeAndTidy {
+		go r.tidyAsync(context.WithoutCancel(ctx), tidyCopy)
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// revoke calls Vault's pki/revoke endpoint for serialNumber, e.g. the serial
+// recorded in o.Status.SerialNumber at deletion, or a serial superseded by
+// rotation.
+func (r *VaultPKISecretReconciler) revoke(ctx context.Context, o *secretsv1alpha1.VaultPKISecret, serialNumber string) error {
+	if serialNumber == "" {
+		return nil
+	}
+
+	vc, err := newAuthenticatedClient(ctx, r.Client, r.ClientFactory, o.Namespace, o.Spec.VaultAuthRef)
+	if err != nil {
+		return err
+	}
+
+	return vault.RevokeCertificate(ctx, vc, o.Spec.Mount, serialNumber)
+}
+
+// tidyAsync runs pki/tidy, with the TidyConfig parameters from o.Spec.Tidy,
+// after the VaultPKISecret this invocation was spawned for has already been
+// deleted from the API. Since there's no longer a live resource to update,
+// failures are only logged; a future enhancement could persist status onto a
+// separate, longer-lived CR (e.g. a VaultPKIMount) to make tidy outcomes
+// queryable after the triggering VaultPKISecret is gone.
+func (r *VaultPKISecretReconciler) tidyAsync(ctx context.Context, o *secretsv1alpha1.VaultPKISecret) {
+	logger := log.FromContext(ctx)
+	if err := r.tidy(ctx, o); err != nil {
+		logger.Error(err, "pki tidy failed", "mount", o.Spec.Mount, "serialNumber", o.Status.SerialNumber)
+	}
+}
+
+// tidy calls Vault's pki/tidy endpoint, purging the revoked serial's metadata
+// from Vault storage per o.Spec.Tidy.TidyCertStore/SafetyBuffer.
+func (r *VaultPKISecretReconciler) tidy(ctx context.Context, o *secretsv1alpha1.VaultPKISecret) error {
+	vc, err := newAuthenticatedClient(ctx, r.Client, r.ClientFactory, o.Namespace, o.Spec.VaultAuthRef)
+	if err != nil {
+		return err
+	}
+
+	return vault.Tidy(ctx, vc, o.Spec.Mount, o.Spec.Tidy.TidyCertStore, o.Spec.Tidy.SafetyBuffer)
+}
+
+// setTidyCondition records the outcome of a RevokeAndTidy teardown on status,
+// for callers that observe the VaultPKISecret while tidy is still in flight.
+func setTidyCondition(status *secretsv1alpha1.VaultPKISecretStatus, reason string, err error) {
+	cond := metav1.Condition{
+		Type:   tidyCondition,
+		Reason: reason,
+		Status: metav1.ConditionTrue,
+	}
+	if err != nil {
+		cond.Status = metav1.ConditionFalse
+		cond.Message = err.Error()
+	}
+	meta.SetStatusCondition(&status.Conditions, cond)
+}
+
+// deleteDestinationSecret removes the destination Secret when Spec.Clear is set.
+func (r *VaultPKISecretReconciler) deleteDestinationSecret(ctx context.Context, o *secretsv1alpha1.VaultPKISecret) error {
+	secret, err := r.getDestinationSecret(ctx, o)
+	if err != nil || secret == nil {
+		return err
+	}
+	if err := r.Client.Delete(ctx, secret); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete destination Secret: %w", err)
+	}
+	return nil
+}
+
+// needsRotation reports whether cert is past its renewal deadline, or no
+// longer matches the CommonName/AltNames/IPSANs requested by spec.
+func needsRotation(cert *x509.Certificate, o *secretsv1alpha1.VaultPKISecret) bool {
+	if cert.Subject.CommonName != o.Spec.CommonName {
+		return true
+	}
+	if !sameStringSet(cert.DNSNames, o.Spec.AltNames) {
+		return true
+	}
+	if !sameIPSet(cert.IPAddresses, o.Spec.IPSANs) {
+		return true
+	}
+	return time.Now().After(renewalDeadline(cert, o))
+}
+
+// sameStringSet reports whether a and b contain the same strings, ignoring order.
+func sameStringSet(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	seen := make(map[string]int, len(a))
+	for _, s := range a {
+		seen[s]++
+	}
+	for _, s := range b {
+		seen[s]--
+	}
+	for _, n := range seen {
+		if n != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// sameIPSet reports whether ips and the string-encoded addrs in specIPs
+// contain the same set of IP addresses, ignoring order.
+func sameIPSet(ips []net.IP, specIPs []string) bool {
+	if len(ips) != len(specIPs) {
+		return false
+	}
+	seen := make(map[string]int, len(ips))
+	for _, ip := range ips {
+		seen[ip.String()]++
+	}
+	for _, s := range specIPs {
+		parsed := net.ParseIP(s)
+		if parsed == nil {
+			return false
+		}
+		seen[parsed.String()]--
+	}
+	for _, n := range seen {
+		if n != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// renewalDeadline is NotAfter - RenewBefore, where RenewBefore falls back to
+// ExpiryOffset when unset, and to 1/3 of the certificate's actual lifetime when
+// neither is set or the value does not parse as a positive duration.
+func renewalDeadline(cert *x509.Certificate, o *secretsv1alpha1.VaultPKISecret) time.Time {
+	renewBefore, err := time.ParseDuration(o.Spec.GetRenewBefore())
+	if err != nil || renewBefore <= 0 {
+		lifetime := cert.NotAfter.Sub(cert.NotBefore)
+		renewBefore = lifetime / 3
+	}
+	return cert.NotAfter.Add(-renewBefore)
+}
+
+// renewalRequeueAfter clamps the duration until the renewal deadline to
+// minRequeueAfter, so an already-elapsed deadline (e.g. due to clock skew, or a
+// cert issued by Vault with a shorter-than-requested TTL) doesn't cause a tight
+// reconcile loop.
+func renewalRequeueAfter(cert *x509.Certificate, o *secretsv1alpha1.VaultPKISecret) time.Duration {
+	d := time.Until(renewalDeadline(cert, o))
+	if d < minRequeueAfter {
+		return minRequeueAfter
+	}
+	return d
+}
+
+func parseLeafCertificate(pemBytes []byte) (*x509.Certificate, error) {
+	if len(pemBytes) == 0 {
+		return nil, fmt.Errorf("no certificate data present")
+	}
+	block, _ := pem.Decode(pemBytes)
+	if block == nil {
+		return nil, fmt.Errorf("failed to decode PEM block")
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
+
+// issuedCertificate is the result of an issue/sign call against Vault's PKI
+// secrets engine.
+type issuedCertificate struct {
+	serialNumber string
+	certPEM      []byte
+	keyPEM       []byte
+	issuingCAPEM []byte
+	leaf         *x509.Certificate
+}
+
+// issueCertificate requests a new certificate from Vault, using the actual
+// NotAfter returned by Vault (not spec.TTL) for all subsequent rotation
+// scheduling, since Vault may issue a cert with a shorter-than-requested TTL.
+// When o.Spec.CSR or o.Spec.PrivateKeySecretRef is set, the private key never
+// leaves the cluster: the controller calls pki/sign/:role with a CSR instead
+// of pki/issue/:role, and verifies the returned leaf's public key matches the
+// CSR's before the result is used.
+func (r *VaultPKISecretReconciler) issueCertificate(ctx context.Context, o *secretsv1alpha1.VaultPKISecret) (*issuedCertificate, error) {
+	vc, err := newAuthenticatedClient(ctx, r.Client, r.ClientFactory, o.Namespace, o.Spec.VaultAuthRef)
+	if err != nil {
+		return nil, err
+	}
+
+	data := map[string]interface{}{
+		"common_name": o.Spec.CommonName,
+	}
+	if o.Spec.Format != "" {
+		data["format"] = o.Spec.Format
+	}
+	if o.Spec.TTL != "" {
+		data["ttl"] = o.Spec.TTL
+	}
+	if len(o.Spec.AltNames) > 0 {
+		data["alt_names"] = strings.Join(o.Spec.AltNames, ",")
+	}
+	if len(o.Spec.IPSANs) > 0 {
+		data["ip_sans"] = strings.Join(o.Spec.IPSANs, ",")
+	}
+
+	var secret *vaultapi.Secret
+	var csr *x509.CertificateRequest
+	var managedKeyPEM []byte
+	switch {
+	case o.Spec.CSR != "":
+		csr, err = parseCertificateRequest(o.Spec.CSR)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse spec.csr: %w", err)
+		}
+		secret, err = vault.SignCertificate(ctx, vc, o.Spec.Mount, o.Spec.Name, o.Spec.CSR, data)
+	case o.Spec.PrivateKeySecretRef != "":
+		var csrPEM string
+		csrPEM, managedKeyPEM, err = r.managedKeyCSR(ctx, o)
+		if err != nil {
+			return nil, err
+		}
+		csr, err = parseCertificateRequest(csrPEM)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse generated CSR: %w", err)
+		}
+		secret, err = vault.SignCertificate(ctx, vc, o.Spec.Mount, o.Spec.Name, csrPEM, data)
+	default:
+		secret, err = vault.IssueCertificate(ctx, vc, o.Spec.Mount, o.Spec.Name, data)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := parseIssuedCertificate(secret)
+	if err != nil {
+		return nil, err
+	}
+
+	if csr != nil {
+		if err := verifyCSRPublicKeyMatch(result.leaf, csr); err != nil {
+			return nil, err
+		}
+	}
+	if managedKeyPEM != nil {
+		// pki/sign never returns private_key, since the key never left the
+		// cluster; write the managed key alongside the signed leaf so the
+		// destination Secret carries a matching tls.crt/tls.key pair.
+		result.keyPEM = managedKeyPEM
+	}
+
+	return result, nil
+}
+
+// parseCertificateRequest decodes a PEM encoded certificate signing request.
+func parseCertificateRequest(csrPEM string) (*x509.CertificateRequest, error) {
+	block, _ := pem.Decode([]byte(csrPEM))
+	if block == nil {
+		return nil, fmt.Errorf("not a valid PEM encoded certificate request")
+	}
+	return x509.ParseCertificateRequest(block.Bytes)
+}
+
+// managedKeyCSR returns a PEM encoded CSR, matching o.Spec's CommonName/AltNames/IPSANs,
+// built from the private key stored in the Secret named by o.Spec.PrivateKeySecretRef. If
+// that Secret does not exist yet, a new key is generated and stored there first, so the same
+// key is reused across rotations instead of Vault minting a new one each time.
+func (r *VaultPKISecretReconciler) managedKeyCSR(ctx context.Context, o *secretsv1alpha1.VaultPKISecret) (string, []byte, error) {
+	keySecret := &corev1.Secret{}
+	key := types.NamespacedName{Namespace: o.Namespace, Name: o.Spec.PrivateKeySecretRef}
+	if err := r.Client.Get(ctx, key, keySecret); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return "", nil, fmt.Errorf("failed to get PrivateKeySecretRef Secret: %w", err)
+		}
+
+		keyPEM, err := generatePrivateKeyPEM()
+		if err != nil {
+			return "", nil, fmt.Errorf("failed to generate private key: %w", err)
+		}
+		keySecret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: o.Spec.PrivateKeySecretRef, Namespace: o.Namespace},
+			Data:       map[string][]byte{"tls.key": keyPEM},
+		}
+		if err := r.Client.Create(ctx, keySecret); err != nil {
+			return "", nil, fmt.Errorf("failed to create PrivateKeySecretRef Secret: %w", err)
+		}
+	}
+
+	keyPEM, ok := keySecret.Data["tls.key"]
+	if !ok {
+		return "", nil, fmt.Errorf("secret %q does not contain a %q key", o.Spec.PrivateKeySecretRef, "tls.key")
+	}
+
+	csrPEM, err := buildCSR(keyPEM, o)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return csrPEM, keyPEM, nil
+}
+
+// generatePrivateKeyPEM generates a new PEM encoded ECDSA P256 private key.
+func generatePrivateKeyPEM() ([]byte, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	der, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}), nil
+}
+
+// buildCSR constructs a PEM encoded CSR, over keyPEM, requesting o.Spec's CommonName/AltNames/IPSANs.
+func buildCSR(keyPEM []byte, o *secretsv1alpha1.VaultPKISecret) (string, error) {
+	block, _ := pem.Decode(keyPEM)
+	if block == nil {
+		return "", fmt.Errorf("failed to decode PEM private key")
+	}
+	key, err := x509.ParseECPrivateKey(block.Bytes)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse private key: %w", err)
+	}
+
+	template := &x509.CertificateRequest{
+		Subject:     pkix.Name{CommonName: o.Spec.CommonName},
+		DNSNames:    o.Spec.AltNames,
+		IPAddresses: parseIPSANs(o.Spec.IPSANs),
+	}
+	der, err := x509.CreateCertificateRequest(rand.Reader, template, key)
+	if err != nil {
+		return "", fmt.Errorf("failed to create certificate request: %w", err)
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: der})), nil
+}
+
+// parseIPSANs parses each of ss as an IP address, skipping any that don't parse.
+func parseIPSANs(ss []string) []net.IP {
+	ips := make([]net.IP, 0, len(ss))
+	for _, s := range ss {
+		if ip := net.ParseIP(s); ip != nil {
+			ips = append(ips, ip)
+		}
+	}
+	return ips
+}
+
+// parseIssuedCertificate translates a pki/issue or pki/sign response into an
+// issuedCertificate. private_key is absent from pki/sign responses, since the
+// caller supplied the CSR and retains the corresponding key itself.
+func parseIssuedCertificate(secret *vaultapi.Secret) (*issuedCertificate, error) {
+	if secret == nil || secret.Data == nil {
+		return nil, fmt.Errorf("vault returned an empty response")
+	}
+
+	certStr, ok := secret.Data["certificate"].(string)
+	if !ok || certStr == "" {
+		return nil, fmt.Errorf("vault response is missing the issued certificate")
+	}
+
+	leaf, err := parseLeafCertificate([]byte(certStr))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse issued certificate: %w", err)
+	}
+
+	var keyPEM []byte
+	if keyStr, ok := secret.Data["private_key"].(string); ok {
+		keyPEM = []byte(keyStr)
+	}
+
+	var issuingCAPEM []byte
+	if caStr, ok := secret.Data["issuing_ca"].(string); ok {
+		issuingCAPEM = []byte(caStr)
+	}
+
+	serial, _ := secret.Data["serial_number"].(string)
+
+	return &issuedCertificate{
+		serialNumber: serial,
+		certPEM:      []byte(certStr),
+		keyPEM:       keyPEM,
+		issuingCAPEM: issuingCAPEM,
+		leaf:         leaf,
+	}, nil
+}
+
+// verifyCSRPublicKeyMatch ensures the leaf certificate Vault returned for a
+// pki/sign/:role request was in fact signed over csr's public key, so a
+// misbehaving or compromised Vault response can't swap in an unrelated key
+// pair for a CSR-pinned rotation.
+func verifyCSRPublicKeyMatch(leaf *x509.Certificate, csr *x509.CertificateRequest) error {
+	leafKey, ok := leaf.PublicKey.(interface{ Equal(x crypto.PublicKey) bool })
+	if !ok {
+		return fmt.Errorf("unsupported leaf public key type %T", leaf.PublicKey)
+	}
+	if !leafKey.Equal(csr.PublicKey) {
+		return fmt.Errorf("issued certificate's public key does not match the CSR's public key")
+	}
+	return nil
+}
+
+// getDestinationSecret returns the existing destination Secret, or nil if it
+// does not exist yet.
+func (r *VaultPKISecretReconciler) getDestinationSecret(ctx context.Context, o *secretsv1alpha1.VaultPKISecret) (*corev1.Secret, error) {
+	secret := &corev1.Secret{}
+	key := types.NamespacedName{Namespace: o.Namespace, Name: o.Spec.Destination.Name}
+	if err := r.Client.Get(ctx, key, secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return secret, nil
+}
+
+// swapSecret atomically replaces tls.crt/tls.key on the destination Secret with
+// the newly issued certificate, retaining the superseded certificate under
+// tls.crt.previous for a grace period so in-flight consumers aren't broken by
+// the rotation.
+func (r *VaultPKISecretReconciler) swapSecret(ctx context.Context, o *secretsv1alpha1.VaultPKISecret, existing *corev1.Secret, cert *issuedCertificate) error {
+	if existing == nil {
+		if !o.Spec.Destination.Create {
+			return fmt.Errorf("destination Secret %q does not exist and destination.create is false", o.Spec.Destination.Name)
+		}
+		existing = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      o.Spec.Destination.Name,
+				Namespace: o.Namespace,
+			},
+		}
+	}
+	if existing.Data == nil {
+		existing.Data = map[string][]byte{}
+	}
+
+	if prev, ok := existing.Data["tls.crt"]; ok {
+		existing.Data["tls.crt.previous"] = prev
+	}
+	existing.Data["tls.crt"] = cert.certPEM
+	if len(cert.keyPEM) > 0 {
+		// Absent when spec.CSR is set: the caller supplied the CSR and
+		// retains the corresponding private key itself, so the existing
+		// tls.key (if any) is left untouched.
+		existing.Data["tls.key"] = cert.keyPEM
+	}
+
+	if existing.ResourceVersion == "" {
+		return r.Client.Create(ctx, existing)
+	}
+	return r.Client.Update(ctx, existing)
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *VaultPKISecretReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&secretsv1alpha1.VaultPKISecret{}).
+		Owns(&corev1.Secret{}).
+		Complete(r)
+}
@@ -0,0 +1,233 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	secretsv1alpha1 "github.com/hashicorp/vault-secrets-operator/api/v1alpha1"
+	"github.com/hashicorp/vault-secrets-operator/internal/vault"
+)
+
+// VaultWebhookCertificateReconciler issues and rotates the TLS certificate
+// backing the operator's own admission/conversion webhook server from Vault
+// PKI, and keeps the caBundle on the webhook configurations that reference
+// this operator in sync with the currently active certificate.
+type VaultWebhookCertificateReconciler struct {
+	client.Client
+	Scheme        *runtime.Scheme
+	ClientFactory *vault.ClientFactory
+
+	// WebhookServerRestart is called after a rotation so the webhook HTTP
+	// server can reload its certificate without dropping in-flight requests.
+	WebhookServerRestart func(certPEM, keyPEM []byte) error
+}
+
+// +kubebuilder:rbac:groups=secrets.hashicorp.com,resources=vaultwebhookcertificates,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=secrets.hashicorp.com,resources=vaultwebhookcertificates/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;create;update;patch
+// +kubebuilder:rbac:groups=admissionregistration.k8s.io,resources=mutatingwebhookconfigurations;validatingwebhookconfigurations,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch;update;patch
+
+func (r *VaultWebhookCertificateReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	o := &secretsv1alpha1.VaultWebhookCertificate{}
+	if err := r.Client.Get(ctx, req.NamespacedName, o); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	existing, err := r.getServingSecret(ctx, o)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if existing != nil {
+		cert, err := parseLeafCertificate(existing.Data["tls.crt"])
+		if err == nil && !needsRotation(cert, pkiSpecFor(o)) {
+			return ctrl.Result{RequeueAfter: renewalRequeueAfter(cert, pkiSpecFor(o))}, nil
+		}
+	}
+
+	cert, err := r.issueWebhookCertificate(ctx, o)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to issue webhook certificate: %w", err)
+	}
+
+	if err := r.writeServingSecret(ctx, o, existing, cert); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to write webhook serving Secret: %w", err)
+	}
+
+	if err := r.patchCABundles(ctx, o, cert.issuingCAPEM); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to patch webhook caBundle: %w", err)
+	}
+
+	if r.WebhookServerRestart != nil {
+		if err := r.WebhookServerRestart(cert.certPEM, cert.keyPEM); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to restart webhook server: %w", err)
+		}
+	}
+
+	return ctrl.Result{RequeueAfter: renewalRequeueAfter(cert.leaf, pkiSpecFor(o))}, nil
+}
+
+// pkiSpecFor adapts a VaultWebhookCertificateSpec's RenewBefore to the shared
+// renewal-deadline math used by VaultPKISecretReconciler.
+func pkiSpecFor(o *secretsv1alpha1.VaultWebhookCertificate) *secretsv1alpha1.VaultPKISecret {
+	return &secretsv1alpha1.VaultPKISecret{
+		Spec: secretsv1alpha1.VaultPKISecretSpec{
+			RenewBefore: o.Spec.RenewBefore,
+			CommonName:  webhookCommonName(o),
+		},
+	}
+}
+
+func webhookCommonName(o *secretsv1alpha1.VaultWebhookCertificate) string {
+	return fmt.Sprintf("%s.%s.svc", o.Spec.ServiceName, o.Spec.ServiceNamespace)
+}
+
+func (r *VaultWebhookCertificateReconciler) issueWebhookCertificate(ctx context.Context, o *secretsv1alpha1.VaultWebhookCertificate) (*issuedCertificate, error) {
+	vc, err := newAuthenticatedClient(ctx, r.Client, r.ClientFactory, o.Namespace, o.Spec.VaultAuthRef)
+	if err != nil {
+		return nil, err
+	}
+
+	data := map[string]interface{}{
+		"common_name": webhookCommonName(o),
+	}
+
+	secret, err := vault.IssueCertificate(ctx, vc, o.Spec.Mount, o.Spec.Role, data)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := parseIssuedCertificate(secret)
+	if err != nil {
+		return nil, err
+	}
+	if len(result.issuingCAPEM) == 0 {
+		return nil, fmt.Errorf("vault response is missing the issuing CA, required to populate the webhook caBundle")
+	}
+
+	return result, nil
+}
+
+func (r *VaultWebhookCertificateReconciler) getServingSecret(ctx context.Context, o *secretsv1alpha1.VaultWebhookCertificate) (*corev1.Secret, error) {
+	secret := &corev1.Secret{}
+	key := types.NamespacedName{Namespace: o.Spec.ServiceNamespace, Name: o.Spec.SecretName}
+	if err := r.Client.Get(ctx, key, secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return secret, nil
+}
+
+func (r *VaultWebhookCertificateReconciler) writeServingSecret(ctx context.Context, o *secretsv1alpha1.VaultWebhookCertificate, existing *corev1.Secret, cert *issuedCertificate) error {
+	if existing == nil {
+		existing = &corev1.Secret{}
+		existing.Name = o.Spec.SecretName
+		existing.Namespace = o.Spec.ServiceNamespace
+	}
+	if existing.Data == nil {
+		existing.Data = map[string][]byte{}
+	}
+	existing.Data["tls.crt"] = cert.certPEM
+	existing.Data["tls.key"] = cert.keyPEM
+	existing.Data["ca.crt"] = cert.issuingCAPEM
+
+	if existing.ResourceVersion == "" {
+		return r.Client.Create(ctx, existing)
+	}
+	return r.Client.Update(ctx, existing)
+}
+
+// patchCABundles updates the caBundle field on every MutatingWebhookConfiguration,
+// ValidatingWebhookConfiguration, and CustomResourceDefinition conversion webhook
+// that references this operator's webhook Service.
+func (r *VaultWebhookCertificateReconciler) patchCABundles(ctx context.Context, o *secretsv1alpha1.VaultWebhookCertificate, caBundle []byte) error {
+	mutating := &admissionregistrationv1.MutatingWebhookConfigurationList{}
+	if err := r.Client.List(ctx, mutating); err != nil {
+		return err
+	}
+	for i := range mutating.Items {
+		wh := &mutating.Items[i]
+		changed := false
+		for j := range wh.Webhooks {
+			if webhookTargetsService(wh.Webhooks[j].ClientConfig.Service, o) {
+				wh.Webhooks[j].ClientConfig.CABundle = caBundle
+				changed = true
+			}
+		}
+		if changed {
+			if err := r.Client.Update(ctx, wh); err != nil {
+				return err
+			}
+		}
+	}
+
+	validating := &admissionregistrationv1.ValidatingWebhookConfigurationList{}
+	if err := r.Client.List(ctx, validating); err != nil {
+		return err
+	}
+	for i := range validating.Items {
+		wh := &validating.Items[i]
+		changed := false
+		for j := range wh.Webhooks {
+			if webhookTargetsService(wh.Webhooks[j].ClientConfig.Service, o) {
+				wh.Webhooks[j].ClientConfig.CABundle = caBundle
+				changed = true
+			}
+		}
+		if changed {
+			if err := r.Client.Update(ctx, wh); err != nil {
+				return err
+			}
+		}
+	}
+
+	crds := &apiextensionsv1.CustomResourceDefinitionList{}
+	if err := r.Client.List(ctx, crds); err != nil {
+		return err
+	}
+	for i := range crds.Items {
+		crd := &crds.Items[i]
+		conv := crd.Spec.Conversion
+		if conv == nil || conv.Webhook == nil || conv.Webhook.ClientConfig == nil {
+			continue
+		}
+		if webhookTargetsService(conv.Webhook.ClientConfig.Service, o) {
+			conv.Webhook.ClientConfig.CABundle = caBundle
+			if err := r.Client.Update(ctx, crd); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func webhookTargetsService(svc *admissionregistrationv1.ServiceReference, o *secretsv1alpha1.VaultWebhookCertificate) bool {
+	return svc != nil && svc.Name == o.Spec.ServiceName && svc.Namespace == o.Spec.ServiceNamespace
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *VaultWebhookCertificateReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&secretsv1alpha1.VaultWebhookCertificate{}).
+		Owns(&corev1.Secret{}).
+		Complete(r)
+}
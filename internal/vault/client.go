@@ -0,0 +1,198 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package vault provides helpers for constructing Vault API clients from the
+// secrets.hashicorp.com CustomResources.
+package vault
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/vault/api"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	secretsv1alpha1 "github.com/hashicorp/vault-secrets-operator/api/v1alpha1"
+)
+
+// ClientFactory builds a Vault API client from a VaultConnection CustomResource.
+type ClientFactory struct {
+	// Client used to resolve the Secret references on the VaultConnection.
+	Client client.Client
+}
+
+// NewClient returns an *api.Client configured from connection, including TLS
+// material resolved from the Secrets referenced by connection.Spec.
+func (f *ClientFactory) NewClient(ctx context.Context, connection *secretsv1alpha1.VaultConnection) (*api.Client, error) {
+	config := api.DefaultConfig()
+	config.Address = connection.Spec.Address
+
+	tlsConfig, err := f.tlsConfig(ctx, connection)
+	if err != nil {
+		return nil, err
+	}
+
+	if tlsConfig != nil {
+		transport, ok := config.HttpClient.Transport.(*http.Transport)
+		if !ok {
+			transport = &http.Transport{}
+		}
+		transport.TLSClientConfig = tlsConfig
+		config.HttpClient.Transport = transport
+	}
+
+	c, err := api.NewClient(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to construct Vault client: %w", err)
+	}
+
+	for k, v := range connection.Spec.Headers {
+		c.AddHeader(k, v)
+	}
+
+	return c, nil
+}
+
+// Login authenticates c against Vault using the Kubernetes auth method
+// configured on authObj, presenting serviceAccountJWT as the login token. The
+// client's namespace is set to authObj.Spec.GetAuthNamespace() for the
+// duration of the login request, since in Vault Enterprise the auth mount may
+// live in a different namespace than the one subsequent PKI/KV requests
+// target. The caller is responsible for switching the client to the target
+// data-path namespace, via c.SetNamespace, before issuing those requests.
+func (f *ClientFactory) Login(ctx context.Context, c *api.Client, authObj *secretsv1alpha1.VaultAuth, serviceAccountJWT string) (*api.Secret, error) {
+	dataNamespace := c.Namespace()
+	c.SetNamespace(authObj.Spec.GetAuthNamespace())
+	defer c.SetNamespace(dataNamespace)
+
+	if authObj.Spec.Kubernetes == nil {
+		return nil, fmt.Errorf("unsupported auth method %q", authObj.Spec.Method)
+	}
+
+	path := fmt.Sprintf("auth/%s/login", authObj.Spec.Mount)
+	secret, err := c.Logical().WriteWithContext(ctx, path, map[string]interface{}{
+		"role": authObj.Spec.Kubernetes.Role,
+		"jwt":  serviceAccountJWT,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to login to Vault auth method %q: %w", path, err)
+	}
+
+	return secret, nil
+}
+
+// LoginKubernetes requests a short-lived, audience-scoped token for the
+// ServiceAccount named by authObj.Spec.Kubernetes, in saNamespace, and uses it
+// to log in to Vault's Kubernetes auth method via Login.
+func (f *ClientFactory) LoginKubernetes(ctx context.Context, c *api.Client, authObj *secretsv1alpha1.VaultAuth, saNamespace string) (*api.Secret, error) {
+	if authObj.Spec.Kubernetes == nil {
+		return nil, fmt.Errorf("unsupported auth method %q", authObj.Spec.Method)
+	}
+
+	jwt, err := f.serviceAccountToken(ctx, saNamespace, authObj.Spec.Kubernetes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ServiceAccount token: %w", err)
+	}
+
+	return f.Login(ctx, c, authObj, jwt)
+}
+
+// serviceAccountToken requests a TokenRequest for the ServiceAccount named by
+// k8sAuth, scoped to k8sAuth.TokenAudiences, rather than reading a long-lived
+// mounted token, so the credential presented to Vault is short-lived.
+func (f *ClientFactory) serviceAccountToken(ctx context.Context, namespace string, k8sAuth *secretsv1alpha1.VaultAuthConfigKubernetes) (string, error) {
+	sa := &corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      k8sAuth.ServiceAccount,
+			Namespace: namespace,
+		},
+	}
+	tr := &authenticationv1.TokenRequest{
+		Spec: authenticationv1.TokenRequestSpec{
+			Audiences: k8sAuth.TokenAudiences,
+		},
+	}
+	if err := f.Client.SubResource("token").Create(ctx, sa, tr); err != nil {
+		return "", fmt.Errorf("failed to create token for ServiceAccount %s/%s: %w", namespace, k8sAuth.ServiceAccount, err)
+	}
+
+	return tr.Status.Token, nil
+}
+
+// tlsConfig builds a *tls.Config from the CA bundle and optional client
+// certificate/key referenced by connection.Spec. Returns nil if no TLS
+// material was configured, leaving Go's default transport behavior in place.
+func (f *ClientFactory) tlsConfig(ctx context.Context, connection *secretsv1alpha1.VaultConnection) (*tls.Config, error) {
+	spec := connection.Spec
+	if spec.CACertSecretRef == "" && spec.ClientCertSecretRef == "" && !spec.SkipTLSVerify && spec.TLSServerName == "" {
+		return nil, nil
+	}
+
+	tlsConfig := &tls.Config{
+		ServerName:         spec.TLSServerName,
+		InsecureSkipVerify: spec.SkipTLSVerify,
+	}
+
+	if spec.CACertSecretRef != "" {
+		caSecret, err := f.getSecret(ctx, connection.Namespace, spec.CACertSecretRef)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get CACertSecretRef %q: %w", spec.CACertSecretRef, err)
+		}
+
+		caCert, ok := caSecret.Data["ca.crt"]
+		if !ok {
+			return nil, fmt.Errorf("secret %q does not contain a %q key", spec.CACertSecretRef, "ca.crt")
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("failed to parse CA bundle from secret %q", spec.CACertSecretRef)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if spec.ClientCertSecretRef != "" {
+		clientSecret, err := f.getSecret(ctx, connection.Namespace, spec.ClientCertSecretRef)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get ClientCertSecretRef %q: %w", spec.ClientCertSecretRef, err)
+		}
+
+		certKey := "tls.crt"
+		keyKey := spec.ClientKeyRef
+		if keyKey == "" {
+			keyKey = "tls.key"
+		}
+
+		cert, ok := clientSecret.Data[certKey]
+		if !ok {
+			return nil, fmt.Errorf("secret %q does not contain a %q key", spec.ClientCertSecretRef, certKey)
+		}
+		key, ok := clientSecret.Data[keyKey]
+		if !ok {
+			return nil, fmt.Errorf("secret %q does not contain a %q key", spec.ClientCertSecretRef, keyKey)
+		}
+
+		clientCert, err := tls.X509KeyPair(cert, key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse client cert/key from secret %q: %w", spec.ClientCertSecretRef, err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{clientCert}
+	}
+
+	return tlsConfig, nil
+}
+
+func (f *ClientFactory) getSecret(ctx context.Context, namespace, name string) (*corev1.Secret, error) {
+	secret := &corev1.Secret{}
+	objKey := client.ObjectKey{Namespace: namespace, Name: name}
+	if err := f.Client.Get(ctx, objKey, secret); err != nil {
+		return nil, err
+	}
+	return secret, nil
+}
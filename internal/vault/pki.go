@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"fmt"
+	"path"
+
+	"github.com/hashicorp/vault/api"
+)
+
+// IssueCertificate calls Vault's pki/issue/:role endpoint, having Vault
+// generate the private key.
+func IssueCertificate(ctx context.Context, c *api.Client, mount, role string, data map[string]interface{}) (*api.Secret, error) {
+	p := path.Join(mount, "issue", role)
+	secret, err := c.Logical().WriteWithContext(ctx, p, data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to issue certificate at %q: %w", p, err)
+	}
+	return secret, nil
+}
+
+// SignCertificate calls Vault's pki/sign/:role endpoint with a caller-supplied
+// CSR, so the corresponding private key never leaves the cluster.
+func SignCertificate(ctx context.Context, c *api.Client, mount, role, csrPEM string, data map[string]interface{}) (*api.Secret, error) {
+	p := path.Join(mount, "sign", role)
+	data["csr"] = csrPEM
+	secret, err := c.Logical().WriteWithContext(ctx, p, data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign certificate at %q: %w", p, err)
+	}
+	return secret, nil
+}
+
+// RevokeCertificate calls Vault's pki/revoke endpoint for serialNumber.
+func RevokeCertificate(ctx context.Context, c *api.Client, mount, serialNumber string) error {
+	p := path.Join(mount, "revoke")
+	_, err := c.Logical().WriteWithContext(ctx, p, map[string]interface{}{
+		"serial_number": serialNumber,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to revoke certificate serial %q at %q: %w", serialNumber, p, err)
+	}
+	return nil
+}
+
+// Tidy calls Vault's pki/tidy endpoint, purging revoked certificate metadata
+// per tidyCertStore/safetyBuffer.
+func Tidy(ctx context.Context, c *api.Client, mount string, tidyCertStore bool, safetyBuffer string) error {
+	p := path.Join(mount, "tidy")
+	_, err := c.Logical().WriteWithContext(ctx, p, map[string]interface{}{
+		"tidy_cert_store": tidyCertStore,
+		"safety_buffer":   safetyBuffer,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to tidy mount %q: %w", p, err)
+	}
+	return nil
+}
@@ -0,0 +1,159 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package integration
+
+import (
+	"context"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gruntwork-io/terratest/modules/files"
+	"github.com/gruntwork-io/terratest/modules/k8s"
+	"github.com/gruntwork-io/terratest/modules/random"
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	secretsv1alpha1 "github.com/hashicorp/vault-secrets-operator/api/v1alpha1"
+)
+
+// TestVaultPKISecret_TLS exercises a VaultConnection pointed at a Vault server
+// fronted by a self-signed TLS listener, configured via CACertSecretRef, and
+// verifies that a VaultPKISecret can still be synced through it.
+func TestVaultPKISecret_TLS(t *testing.T) {
+	if testWithHelm {
+		t.Skipf("Test is not compatiable with Helm")
+	}
+
+	testID := strings.ToLower(random.UniqueId())
+	testK8sNamespace := "k8s-tenant-tls-" + testID
+	testPKIMountPath := "pki-tls-" + testID
+	testVaultConnectionName := "vaultconnection-test-tls-1"
+	testVaultAuthMethodName := "vaultauth-test-tls-1"
+	testVaultAuthMethodRole := "role1"
+	testCACertSecretName := "vault-tls-ca"
+
+	operatorNS := os.Getenv("OPERATOR_NAMESPACE")
+	require.NotEmpty(t, operatorNS, "OPERATOR_NAMESPACE is not set")
+
+	clusterName := os.Getenv("KIND_CLUSTER_NAME")
+	require.NotEmpty(t, clusterName, "KIND_CLUSTER_NAME is not set")
+	k8sConfigContext := "kind-" + clusterName
+	k8sOpts := &k8s.KubectlOptions{
+		ContextName: k8sConfigContext,
+		Namespace:   operatorNS,
+	}
+	kustomizeConfigPath := filepath.Join(kustomizeConfigRoot, "default")
+	deployOperatorWithKustomize(t, k8sOpts, kustomizeConfigPath)
+
+	tempDir, err := os.MkdirTemp(os.TempDir(), t.Name())
+	require.Nil(t, err)
+
+	tfDir, err := files.CopyTerraformFolderToDest(
+		path.Join(testRoot, "vaultconnection-tls/terraform"),
+		tempDir,
+		"terraform",
+	)
+	require.Nil(t, err)
+
+	terraformOptions := &terraform.Options{
+		TerraformDir: tfDir,
+		Vars: map[string]interface{}{
+			"k8s_test_namespace":     testK8sNamespace,
+			"k8s_config_context":     k8sConfigContext,
+			"vault_pki_mount_path":   testPKIMountPath,
+			"vault_tls_secret_name":  testCACertSecretName,
+			"operator_helm_chart_path": chartPath,
+		},
+	}
+	terraformOptions = setCommonTFOptions(t, terraformOptions)
+
+	t.Cleanup(func() {
+		exportKindLogs(t)
+		terraform.Destroy(t, terraformOptions)
+		os.RemoveAll(tempDir)
+		k8s.KubectlDeleteFromKustomize(t, k8sOpts, kustomizeConfigPath)
+	})
+
+	tfOutputs := terraform.InitAndApply(t, terraformOptions)
+	vaultTLSAddress := terraform.Output(t, terraformOptions, "vault_tls_address")
+	_ = tfOutputs
+
+	crdClient := getCRDClient(t)
+	ctx := context.Background()
+
+	testVaultConnection := &secretsv1alpha1.VaultConnection{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      testVaultConnectionName,
+			Namespace: testK8sNamespace,
+		},
+		Spec: secretsv1alpha1.VaultConnectionSpec{
+			Address:         vaultTLSAddress,
+			CACertSecretRef: testCACertSecretName,
+		},
+	}
+	defer crdClient.Delete(ctx, testVaultConnection)
+	require.NoError(t, crdClient.Create(ctx, testVaultConnection))
+
+	testVaultAuth := &secretsv1alpha1.VaultAuth{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      testVaultAuthMethodName,
+			Namespace: testK8sNamespace,
+		},
+		Spec: secretsv1alpha1.VaultAuthSpec{
+			VaultConnectionRef: testVaultConnectionName,
+			Method:             "kubernetes",
+			Mount:              "kubernetes",
+			Kubernetes: &secretsv1alpha1.VaultAuthConfigKubernetes{
+				Role:           testVaultAuthMethodRole,
+				ServiceAccount: "default",
+				TokenAudiences: []string{"vault"},
+			},
+		},
+	}
+	defer crdClient.Delete(ctx, testVaultAuth)
+	require.NoError(t, crdClient.Create(ctx, testVaultAuth))
+
+	vpsObj := &secretsv1alpha1.VaultPKISecret{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "vaultpki-test-tls-1",
+			Namespace: testK8sNamespace,
+		},
+		Spec: secretsv1alpha1.VaultPKISecretSpec{
+			VaultAuthRef: testVaultAuthMethodName,
+			Mount:        testPKIMountPath,
+			Name:         "secret",
+			CommonName:   "tls1.example.com",
+			Format:       "pem",
+			Revoke:       true,
+			Clear:        true,
+			ExpiryOffset: "5s",
+			TTL:          "15s",
+			Destination: secretsv1alpha1.Destination{
+				Name:   "pki-tls-1",
+				Create: true,
+			},
+		},
+	}
+	t.Cleanup(func() {
+		assert.NoError(t, crdClient.Delete(ctx, vpsObj))
+	})
+	require.NoError(t, crdClient.Create(ctx, vpsObj))
+
+	serialNumber, secret, err := waitForPKIData(t, 30, 1*time.Second,
+		vpsObj.Spec.Destination.Name, vpsObj.ObjectMeta.Namespace,
+		vpsObj.Spec.CommonName, "",
+	)
+	require.NoError(t, err)
+	assert.NotEmpty(t, serialNumber)
+
+	assertSyncableSecret(t, vpsObj,
+		"secrets.hashicorp.com/v1alpha1",
+		"VaultPKISecret", secret)
+}
@@ -5,6 +5,12 @@ package integration
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
 	"fmt"
 	"os"
 	"path"
@@ -33,6 +39,7 @@ func TestVaultPKISecret(t *testing.T) {
 	testK8sNamespace := "k8s-tenant-" + testID
 	testPKIMountPath := "pki-" + testID
 	testVaultNamespace := ""
+	testVaultAuthNamespace := ""
 	testVaultConnectionName := "vaultconnection-test-tenant-1"
 	testVaultAuthMethodName := "vaultauth-test-tenant-1"
 	testVaultAuthMethodRole := "role1"
@@ -77,8 +84,13 @@ func TestVaultPKISecret(t *testing.T) {
 	}
 	if entTests {
 		testVaultNamespace = "vault-tenant-" + testID
+		testVaultAuthNamespace = "vault-tenant-auth-" + testID
 		terraformOptions.Vars["vault_enterprise"] = true
 		terraformOptions.Vars["vault_test_namespace"] = testVaultNamespace
+		// The auth mount lives in its own parent namespace, separate from the
+		// namespace the PKI secrets engine is mounted in, exercising the
+		// AuthNamespace/Namespace split on VaultAuthSpec.
+		terraformOptions.Vars["vault_test_auth_namespace"] = testVaultAuthNamespace
 	}
 	terraformOptions = setCommonTFOptions(t, terraformOptions)
 
@@ -129,6 +141,7 @@ func TestVaultPKISecret(t *testing.T) {
 			Spec: secretsv1alpha1.VaultAuthSpec{
 				VaultConnectionRef: testVaultConnectionName,
 				Namespace:          testVaultNamespace,
+				AuthNamespace:      testVaultAuthNamespace,
 				Method:             "kubernetes",
 				Mount:              "kubernetes",
 				Kubernetes: &secretsv1alpha1.VaultAuthConfigKubernetes{
@@ -181,6 +194,7 @@ func TestVaultPKISecret(t *testing.T) {
 		name     string
 		existing []*secretsv1alpha1.VaultPKISecret
 		create   int
+		csr      bool
 	}{
 		{
 			name:     "existing-only",
@@ -195,6 +209,11 @@ func TestVaultPKISecret(t *testing.T) {
 			existing: getExisting(),
 			create:   5,
 		},
+		{
+			name:   "csr-sign",
+			create: 3,
+			csr:    true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -208,26 +227,37 @@ func TestVaultPKISecret(t *testing.T) {
 
 			for idx := 0; idx < tt.create; idx++ {
 				dest := fmt.Sprintf("%s-create-%d", tt.name, idx)
+				commonName := fmt.Sprintf("%s.example.com", dest)
+				spec := secretsv1alpha1.VaultPKISecretSpec{
+					Name:         "secret",
+					Namespace:    testVaultNamespace,
+					Mount:        testPKIMountPath,
+					CommonName:   commonName,
+					Format:       "pem",
+					Revoke:       true,
+					ExpiryOffset: "5s",
+					TTL:          "15s",
+					VaultAuthRef: testVaultAuthMethodName,
+					Destination: secretsv1alpha1.Destination{
+						Name:   dest,
+						Create: true,
+					},
+				}
+
+				if tt.csr {
+					// CommonName must match the CSR's own subject CommonName, so the
+					// CSR is generated for the same commonName used elsewhere in this spec.
+					csrPEM, err := generateTestCSR(commonName)
+					require.NoError(t, err)
+					spec.CSR = csrPEM
+				}
+
 				toTest = append(toTest, &secretsv1alpha1.VaultPKISecret{
 					ObjectMeta: v1.ObjectMeta{
 						Name:      dest,
 						Namespace: testK8sNamespace,
 					},
-					Spec: secretsv1alpha1.VaultPKISecretSpec{
-						Name:         "secret",
-						Namespace:    testVaultNamespace,
-						Mount:        testPKIMountPath,
-						CommonName:   fmt.Sprintf("%s.example.com", dest),
-						Format:       "pem",
-						Revoke:       true,
-						ExpiryOffset: "5s",
-						TTL:          "15s",
-						VaultAuthRef: testVaultAuthMethodName,
-						Destination: secretsv1alpha1.Destination{
-							Name:   dest,
-							Create: true,
-						},
-					},
+					Spec: spec,
 				})
 			}
 
@@ -279,3 +309,159 @@ func TestVaultPKISecret(t *testing.T) {
 		})
 	}
 }
+
+// TestVaultPKISecret_RevokeAndTidy verifies that a VaultPKISecret configured
+// with CleanupPolicy: RevokeAndTidy not only revokes its certificate on
+// deletion, but also purges the serial's metadata from Vault's pki/certs
+// listing once the asynchronous tidy has run.
+func TestVaultPKISecret_RevokeAndTidy(t *testing.T) {
+	if testWithHelm {
+		t.Skipf("Test is not compatiable with Helm")
+	}
+
+	testID := strings.ToLower(random.UniqueId())
+	testK8sNamespace := "k8s-tenant-tidy-" + testID
+	testPKIMountPath := "pki-tidy-" + testID
+	testVaultConnectionName := "vaultconnection-test-tidy-1"
+	testVaultAuthMethodName := "vaultauth-test-tidy-1"
+	testVaultAuthMethodRole := "role1"
+
+	operatorNS := os.Getenv("OPERATOR_NAMESPACE")
+	require.NotEmpty(t, operatorNS, "OPERATOR_NAMESPACE is not set")
+
+	clusterName := os.Getenv("KIND_CLUSTER_NAME")
+	require.NotEmpty(t, clusterName, "KIND_CLUSTER_NAME is not set")
+	k8sConfigContext := "kind-" + clusterName
+	k8sOpts := &k8s.KubectlOptions{
+		ContextName: k8sConfigContext,
+		Namespace:   operatorNS,
+	}
+	kustomizeConfigPath := filepath.Join(kustomizeConfigRoot, "default")
+	deployOperatorWithKustomize(t, k8sOpts, kustomizeConfigPath)
+
+	tempDir, err := os.MkdirTemp(os.TempDir(), t.Name())
+	require.Nil(t, err)
+
+	tfDir, err := files.CopyTerraformFolderToDest(
+		path.Join(testRoot, "vaultpkisecret/terraform"),
+		tempDir,
+		"terraform",
+	)
+	require.Nil(t, err)
+
+	terraformOptions := &terraform.Options{
+		TerraformDir: tfDir,
+		Vars: map[string]interface{}{
+			"deploy_operator_via_helm":     testWithHelm,
+			"k8s_vault_connection_address": testVaultAddress,
+			"k8s_test_namespace":           testK8sNamespace,
+			"k8s_config_context":           k8sConfigContext,
+			"vault_pki_mount_path":         testPKIMountPath,
+			"operator_helm_chart_path":     chartPath,
+		},
+	}
+	terraformOptions = setCommonTFOptions(t, terraformOptions)
+
+	t.Cleanup(func() {
+		exportKindLogs(t)
+		terraform.Destroy(t, terraformOptions)
+		os.RemoveAll(tempDir)
+		k8s.KubectlDeleteFromKustomize(t, k8sOpts, kustomizeConfigPath)
+	})
+
+	terraform.InitAndApply(t, terraformOptions)
+
+	crdClient := getCRDClient(t)
+	vaultClient := getVaultClient(t)
+	ctx := context.Background()
+
+	testVaultConnection := &secretsv1alpha1.VaultConnection{
+		ObjectMeta: v1.ObjectMeta{Name: testVaultConnectionName, Namespace: testK8sNamespace},
+		Spec:       secretsv1alpha1.VaultConnectionSpec{Address: testVaultAddress},
+	}
+	defer crdClient.Delete(ctx, testVaultConnection)
+	require.NoError(t, crdClient.Create(ctx, testVaultConnection))
+
+	testVaultAuth := &secretsv1alpha1.VaultAuth{
+		ObjectMeta: v1.ObjectMeta{Name: testVaultAuthMethodName, Namespace: testK8sNamespace},
+		Spec: secretsv1alpha1.VaultAuthSpec{
+			VaultConnectionRef: testVaultConnectionName,
+			Method:             "kubernetes",
+			Mount:              "kubernetes",
+			Kubernetes: &secretsv1alpha1.VaultAuthConfigKubernetes{
+				Role:           testVaultAuthMethodRole,
+				ServiceAccount: "default",
+				TokenAudiences: []string{"vault"},
+			},
+		},
+	}
+	defer crdClient.Delete(ctx, testVaultAuth)
+	require.NoError(t, crdClient.Create(ctx, testVaultAuth))
+
+	vpsObj := &secretsv1alpha1.VaultPKISecret{
+		ObjectMeta: v1.ObjectMeta{Name: "vaultpki-test-tidy-1", Namespace: testK8sNamespace},
+		Spec: secretsv1alpha1.VaultPKISecretSpec{
+			VaultAuthRef:  testVaultAuthMethodName,
+			Mount:         testPKIMountPath,
+			Name:          "secret",
+			CommonName:    "tidy1.example.com",
+			Format:        "pem",
+			CleanupPolicy: secretsv1alpha1.CleanupPolicyRevokeAndTidy,
+			Tidy: secretsv1alpha1.TidyConfig{
+				TidyCertStore: true,
+				SafetyBuffer:  "1s",
+			},
+			Clear: true,
+			TTL:   "15s",
+			Destination: secretsv1alpha1.Destination{
+				Name:   "pki-tidy-1",
+				Create: true,
+			},
+		},
+	}
+	require.NoError(t, crdClient.Create(ctx, vpsObj))
+
+	serialNumber, _, err := waitForPKIData(t, 30, 1*time.Second,
+		vpsObj.Spec.Destination.Name, vpsObj.ObjectMeta.Namespace,
+		vpsObj.Spec.CommonName, "",
+	)
+	require.NoError(t, err)
+	require.NotEmpty(t, serialNumber)
+
+	require.NoError(t, crdClient.Delete(ctx, vpsObj))
+
+	require.Eventually(t, func() bool {
+		resp, err := vaultClient.Logical().List(path.Join(testPKIMountPath, "certs"))
+		if err != nil || resp == nil {
+			return false
+		}
+		for _, key := range resp.Data["keys"].([]interface{}) {
+			if strings.EqualFold(key.(string), serialNumber) {
+				return false
+			}
+		}
+		return true
+	}, 60*time.Second, 2*time.Second, "serial %q was still listed under pki/certs after RevokeAndTidy teardown", serialNumber)
+}
+
+// generateTestCSR generates a throwaway ECDSA private key and returns a PEM
+// encoded certificate signing request for commonName, exercising the
+// csr-sign test case's externally-managed-private-key flow.
+func generateTestCSR(commonName string) (string, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return "", err
+	}
+
+	template := &x509.CertificateRequest{
+		Subject: pkix.Name{CommonName: commonName},
+	}
+
+	csrDER, err := x509.CreateCertificateRequest(rand.Reader, template, key)
+	if err != nil {
+		return "", err
+	}
+
+	csrPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csrDER})
+	return string(csrPEM), nil
+}
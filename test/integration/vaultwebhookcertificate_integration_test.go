@@ -0,0 +1,117 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package integration
+
+import (
+	"context"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gruntwork-io/terratest/modules/files"
+	"github.com/gruntwork-io/terratest/modules/k8s"
+	"github.com/gruntwork-io/terratest/modules/random"
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	secretsv1alpha1 "github.com/hashicorp/vault-secrets-operator/api/v1alpha1"
+)
+
+// TestVaultWebhookCertificate verifies that the operator's own webhook serving
+// certificate can be sourced from a Vault PKI role whose allowed_domains match
+// the operator Service's DNS name, and that the webhook comes up healthy using
+// the Vault-issued certificate.
+func TestVaultWebhookCertificate(t *testing.T) {
+	if testWithHelm {
+		t.Skipf("Test is not compatiable with Helm")
+	}
+
+	testID := strings.ToLower(random.UniqueId())
+	testPKIMountPath := "pki-webhook-" + testID
+	testPKIRole := "webhook-role"
+
+	operatorNS := os.Getenv("OPERATOR_NAMESPACE")
+	require.NotEmpty(t, operatorNS, "OPERATOR_NAMESPACE is not set")
+
+	clusterName := os.Getenv("KIND_CLUSTER_NAME")
+	require.NotEmpty(t, clusterName, "KIND_CLUSTER_NAME is not set")
+	k8sConfigContext := "kind-" + clusterName
+	k8sOpts := &k8s.KubectlOptions{
+		ContextName: k8sConfigContext,
+		Namespace:   operatorNS,
+	}
+	kustomizeConfigPath := filepath.Join(kustomizeConfigRoot, "default")
+	deployOperatorWithKustomize(t, k8sOpts, kustomizeConfigPath)
+
+	tempDir, err := os.MkdirTemp(os.TempDir(), t.Name())
+	require.Nil(t, err)
+
+	tfDir, err := files.CopyTerraformFolderToDest(
+		path.Join(testRoot, "vaultwebhookcertificate/terraform"),
+		tempDir,
+		"terraform",
+	)
+	require.Nil(t, err)
+
+	terraformOptions := &terraform.Options{
+		TerraformDir: tfDir,
+		Vars: map[string]interface{}{
+			"k8s_vault_connection_address": testVaultAddress,
+			"k8s_config_context":           k8sConfigContext,
+			"operator_namespace":           operatorNS,
+			"vault_pki_mount_path":         testPKIMountPath,
+			"vault_pki_role":               testPKIRole,
+			"operator_helm_chart_path":     chartPath,
+		},
+	}
+	terraformOptions = setCommonTFOptions(t, terraformOptions)
+
+	t.Cleanup(func() {
+		exportKindLogs(t)
+		terraform.Destroy(t, terraformOptions)
+		os.RemoveAll(tempDir)
+		k8s.KubectlDeleteFromKustomize(t, k8sOpts, kustomizeConfigPath)
+	})
+
+	terraform.InitAndApply(t, terraformOptions)
+
+	crdClient := getCRDClient(t)
+	ctx := context.Background()
+
+	webhookCert := &secretsv1alpha1.VaultWebhookCertificate{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "operator-webhook-cert",
+			Namespace: operatorNS,
+		},
+		Spec: secretsv1alpha1.VaultWebhookCertificateSpec{
+			Mount:            testPKIMountPath,
+			Role:             testPKIRole,
+			ServiceName:      "vault-secrets-operator-webhook-service",
+			ServiceNamespace: operatorNS,
+			SecretName:       "vault-secrets-operator-webhook-cert",
+			RenewBefore:      "1h",
+		},
+	}
+	t.Cleanup(func() {
+		assert.NoError(t, crdClient.Delete(ctx, webhookCert))
+	})
+	require.NoError(t, crdClient.Create(ctx, webhookCert))
+
+	require.Eventually(t, func() bool {
+		secret, err := k8s.GetSecretE(t, k8sOpts, webhookCert.Spec.SecretName)
+		if err != nil {
+			return false
+		}
+		return len(secret.Data["tls.crt"]) > 0 && len(secret.Data["tls.key"]) > 0 && len(secret.Data["ca.crt"]) > 0
+	}, 60*time.Second, 2*time.Second, "webhook serving Secret was never populated from Vault PKI")
+
+	secret, err := k8s.GetSecretE(t, k8sOpts, webhookCert.Spec.SecretName)
+	require.NoError(t, err)
+	assert.NotEqual(t, secret.Data["tls.crt"], secret.Data["ca.crt"], "ca.crt must be Vault's issuing CA, not the leaf certificate")
+}